@@ -0,0 +1,330 @@
+package chainbridge
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/lightninglabs/taro/tapgarden"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// confSubscription is a single pending RegisterConfirmationsNtfn call that
+// the dispatch loop checks against every newly connected block.
+type confSubscription struct {
+	txid       *chainhash.Hash
+	numConfs   uint32
+	heightHint uint32
+
+	confChan chan *chainntnfs.TxConfirmation
+	errChan  chan error
+}
+
+// RPCChainBridge is an implementation of the tapgarden.ChainBridge interface
+// backed directly by a btcd or bitcoind full node connection, rather than by
+// an lnd instance.
+//
+// chain.RPCClient.Notifications() is a single dequeue channel: an event is
+// delivered to whichever goroutine happens to be reading it, not broadcast
+// to every interested party. RPCChainBridge therefore runs exactly one
+// dispatch loop over that channel, and fans each event out to every
+// registered subscriber itself, the same way lnd's own chainntnfs backends
+// do internally.
+type RPCChainBridge struct {
+	chain       *chain.RPCClient
+	chainParams *chaincfg.Params
+
+	mu         sync.Mutex
+	blockSubs  map[int]chan int32
+	confSubs   []*confSubscription
+	nextSubID  int
+	dispatchWg sync.WaitGroup
+	started    sync.Once
+	startErr   error
+}
+
+// NewRPCChainBridge creates a new chain bridge from the given chain
+// connection. chainParams is needed to turn a pkScript into the watch
+// address chain.Interface.NotifyReceived expects.
+func NewRPCChainBridge(chainConn *chain.RPCClient,
+	chainParams *chaincfg.Params) *RPCChainBridge {
+
+	return &RPCChainBridge{
+		chain:       chainConn,
+		chainParams: chainParams,
+		blockSubs:   make(map[int]chan int32),
+	}
+}
+
+// start launches the dispatch loop the first time it's needed, returning any
+// error encountered doing so. It is safe to call repeatedly: sync.Once means
+// the dispatch loop is only ever attempted once, so a failure here is
+// permanent and every call returns the same error.
+func (r *RPCChainBridge) start() error {
+	r.started.Do(func() {
+		if err := r.chain.NotifyBlocks(); err != nil {
+			r.startErr = fmt.Errorf("unable to subscribe to "+
+				"block notifications: %w", err)
+			return
+		}
+
+		r.dispatchWg.Add(1)
+		go r.dispatch()
+	})
+
+	return r.startErr
+}
+
+// dispatch is the single consumer of r.chain.Notifications(). It fans each
+// block connected event out to every registered block-epoch and
+// confirmation subscriber.
+func (r *RPCChainBridge) dispatch() {
+	defer r.dispatchWg.Done()
+
+	for update := range r.chain.Notifications() {
+		block, ok := update.(chain.BlockConnected)
+		if !ok {
+			continue
+		}
+
+		r.notifyBlockSubs(block.Height)
+		r.notifyConfSubs(block)
+	}
+}
+
+// notifyBlockSubs delivers a new block height to every registered block
+// epoch subscriber. The send never blocks: a subscriber whose channel still
+// holds an undelivered height does not receive this one.
+func (r *RPCChainBridge) notifyBlockSubs(height int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, sub := range r.blockSubs {
+		select {
+		case sub <- height:
+		default:
+		}
+	}
+}
+
+// notifyConfSubs checks the given newly connected block against every
+// pending confirmation subscription, delivering and removing any that have
+// now reached their required depth.
+func (r *RPCChainBridge) notifyConfSubs(block chain.BlockConnected) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.confSubs) == 0 {
+		return
+	}
+
+	if uint32(block.Height) < r.lowestHeightHint() {
+		return
+	}
+
+	rawBlock, err := r.chain.GetBlock(&block.Hash)
+	if err != nil {
+		r.failAllConfSubsLocked(err)
+		return
+	}
+
+	_, bestHeight, err := r.chain.GetBestBlock()
+	if err != nil {
+		r.failAllConfSubsLocked(err)
+		return
+	}
+
+	remaining := r.confSubs[:0]
+	for _, sub := range r.confSubs {
+		if uint32(block.Height) < sub.heightHint {
+			remaining = append(remaining, sub)
+			continue
+		}
+
+		idx, tx := findTx(rawBlock, sub.txid)
+		if tx == nil {
+			remaining = append(remaining, sub)
+			continue
+		}
+
+		confsSoFar := uint32(bestHeight-block.Height) + 1
+		if confsSoFar < sub.numConfs {
+			remaining = append(remaining, sub)
+			continue
+		}
+
+		sub.confChan <- &chainntnfs.TxConfirmation{
+			BlockHash:   &block.Hash,
+			BlockHeight: uint32(block.Height),
+			TxIndex:     uint32(idx),
+			Tx:          tx,
+		}
+	}
+	r.confSubs = remaining
+}
+
+// failAllConfSubsLocked delivers err to every pending confirmation
+// subscriber and clears the list. The caller must hold r.mu.
+func (r *RPCChainBridge) failAllConfSubsLocked(err error) {
+	for _, sub := range r.confSubs {
+		sub.errChan <- err
+	}
+	r.confSubs = nil
+}
+
+// lowestHeightHint returns the smallest heightHint among pending
+// confirmation subscribers, so notifyConfSubs can cheaply skip blocks that
+// are too old to matter to anyone. The caller must hold r.mu.
+func (r *RPCChainBridge) lowestHeightHint() uint32 {
+	lowest := r.confSubs[0].heightHint
+	for _, sub := range r.confSubs[1:] {
+		if sub.heightHint < lowest {
+			lowest = sub.heightHint
+		}
+	}
+
+	return lowest
+}
+
+// findTx looks for txid among block's transactions, returning its index and
+// the transaction itself if found.
+func findTx(block *wire.MsgBlock, txid *chainhash.Hash) (int, *wire.MsgTx) {
+	for i, tx := range block.Transactions {
+		if tx.TxHash() == *txid {
+			return i, tx
+		}
+	}
+
+	return 0, nil
+}
+
+// RegisterConfirmationsNtfn registers an intent to be notified once a
+// transaction with the given pkScript confirms on chain.
+func (r *RPCChainBridge) RegisterConfirmationsNtfn(_ context.Context,
+	txid *chainhash.Hash, pkScript []byte, numConfs,
+	heightHint uint32) (*chainntnfs.ConfirmationEvent, chan error, error) {
+
+	if err := r.start(); err != nil {
+		return nil, nil, err
+	}
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		pkScript, r.chainParams,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to extract watch "+
+			"address from pkScript: %w", err)
+	}
+
+	if err := r.chain.NotifyReceived(addrs); err != nil {
+		return nil, nil, fmt.Errorf("unable to register "+
+			"confirmation notification: %w", err)
+	}
+
+	sub := &confSubscription{
+		txid:       txid,
+		numConfs:   numConfs,
+		heightHint: heightHint,
+		confChan:   make(chan *chainntnfs.TxConfirmation, 1),
+		errChan:    make(chan error, 1),
+	}
+
+	r.mu.Lock()
+	r.confSubs = append(r.confSubs, sub)
+	r.mu.Unlock()
+
+	return &chainntnfs.ConfirmationEvent{
+		Confirmed: sub.confChan,
+	}, sub.errChan, nil
+}
+
+// RegisterBlockEpochNtfn registers an intent to be notified of new blocks
+// connected to the main chain. The returned channel has a buffer of one, and
+// a height arriving while it is still full is dropped for this subscriber.
+func (r *RPCChainBridge) RegisterBlockEpochNtfn(
+	_ context.Context) (chan int32, chan error, error) {
+
+	if err := r.start(); err != nil {
+		return nil, nil, err
+	}
+
+	blockChan := make(chan int32, 1)
+	errChan := make(chan error, 1)
+
+	r.mu.Lock()
+	subID := r.nextSubID
+	r.nextSubID++
+	r.blockSubs[subID] = blockChan
+	r.mu.Unlock()
+
+	return blockChan, errChan, nil
+}
+
+// GetBlock returns the block with the given hash.
+func (r *RPCChainBridge) GetBlock(
+	hash chainhash.Hash) (*wire.MsgBlock, error) {
+
+	return r.chain.GetBlock(&hash)
+}
+
+// GetBlockHash returns the hash of the block at the given height.
+func (r *RPCChainBridge) GetBlockHash(
+	blockHeight int64) (chainhash.Hash, error) {
+
+	hash, err := r.chain.GetBlockHash(blockHeight)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	return *hash, nil
+}
+
+// CurrentHeight returns the best known height of the backing full node.
+func (r *RPCChainBridge) CurrentHeight() (uint32, error) {
+	_, bestHeight, err := r.chain.GetBestBlock()
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch best block: %w", err)
+	}
+
+	return uint32(bestHeight), nil
+}
+
+// PublishTransaction broadcasts the given transaction to the network.
+func (r *RPCChainBridge) PublishTransaction(tx *wire.MsgTx) error {
+	_, err := r.chain.SendRawTransaction(tx, false)
+	return err
+}
+
+// EstimateFee returns a fee estimate for confirming a transaction within the
+// given number of blocks.
+func (r *RPCChainBridge) EstimateFee(
+	confTarget uint32) (chainfee.SatPerKWeight, error) {
+
+	resp, err := r.chain.EstimateSmartFee(
+		int64(confTarget), &btcjson.EstimateModeConservative,
+	)
+	if err != nil || resp.FeeRate == nil {
+		// The node couldn't produce an estimate, most likely because
+		// it hasn't seen enough blocks or mempool activity yet (the
+		// common case on a fresh regtest/simnet chain). Fall back to
+		// the static relay fee floor rather than failing the caller
+		// outright.
+		return chainfee.FeePerKwFloor, nil
+	}
+
+	satPerKVByte := btcutil.Amount(*resp.FeeRate * btcutil.SatoshiPerBitcoin)
+
+	return chainfee.SatPerKVByte(satPerKVByte).FeePerKWeight(), nil
+}
+
+// A compile-time check to ensure RPCChainBridge implements the
+// tapgarden.ChainBridge interface.
+var _ tapgarden.ChainBridge = (*RPCChainBridge)(nil)
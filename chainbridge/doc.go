@@ -0,0 +1,8 @@
+// Package chainbridge provides direct-RPC implementations of the taro
+// backend interfaces (tapgarden.ChainBridge, tapfreighter.WalletAnchor,
+// taro.KeyRing, tapscript.Signer, and tapgarden.GenSigner) on top of a
+// btcwallet instance and a btcd/bitcoind full node connection.
+//
+// These mirror the lnd-backed implementations in the taro package, but let
+// a taro daemon run against a full node directly instead of through lnd.
+package chainbridge
@@ -0,0 +1,40 @@
+package chainbridge
+
+import (
+	"github.com/lightninglabs/taro"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+)
+
+// BtcWalletKeyRing is an implementation of the taro.KeyRing interface backed
+// directly by a local btcwallet instance, rather than by an lnd instance.
+type BtcWalletKeyRing struct {
+	keychain.KeyRing
+}
+
+// NewBtcWalletKeyRing creates a new key ring backed by the given btcwallet
+// instance, deriving keys under the given coin type.
+func NewBtcWalletKeyRing(wallet *btcwallet.BtcWallet,
+	coinType uint32) *BtcWalletKeyRing {
+
+	return &BtcWalletKeyRing{
+		KeyRing: keychain.NewBtcWalletKeyRing(
+			wallet.InternalWallet(), coinType,
+		),
+	}
+}
+
+// IsLocalKey returns true if the given key descriptor was derived by this
+// key ring.
+func (b *BtcWalletKeyRing) IsLocalKey(desc keychain.KeyDescriptor) bool {
+	derived, err := b.DeriveKey(desc.KeyLocator)
+	if err != nil || desc.PubKey == nil {
+		return false
+	}
+
+	return derived.PubKey.IsEqual(desc.PubKey)
+}
+
+// A compile-time check to ensure BtcWalletKeyRing implements the
+// taro.KeyRing interface.
+var _ taro.KeyRing = (*BtcWalletKeyRing)(nil)
@@ -0,0 +1,76 @@
+package chainbridge
+
+import (
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/tapgarden"
+	"github.com/lightninglabs/taro/tapscript"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/input"
+)
+
+// BtcWalletSigner is an implementation of the tapscript.Signer interface
+// backed directly by a local btcwallet instance, rather than by an lnd
+// instance.
+type BtcWalletSigner struct {
+	wallet *btcwallet.BtcWallet
+}
+
+// NewBtcWalletSigner creates a new virtual transaction signer backed by the
+// given btcwallet instance.
+func NewBtcWalletSigner(wallet *btcwallet.BtcWallet) *BtcWalletSigner {
+	return &BtcWalletSigner{
+		wallet: wallet,
+	}
+}
+
+// SignVirtualTx produces a witness for the given virtual asset transaction
+// input through the wallet's ComputeInputScript, which signs with the wallet
+// key controlling signDesc.Output's pkScript rather than with
+// signDesc.KeyDesc. prevOut is not used.
+func (b *BtcWalletSigner) SignVirtualTx(signDesc *input.SignDescriptor,
+	tx *wire.MsgTx, prevOut *wire.TxOut) (*wire.TxWitness, error) {
+
+	witness, err := b.wallet.ComputeInputScript(tx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &witness.Witness, nil
+}
+
+// BtcWalletGenSigner is an implementation of the tapgarden.GenSigner
+// interface backed directly by a local btcwallet instance, rather than by an
+// lnd instance.
+type BtcWalletGenSigner struct {
+	wallet *btcwallet.BtcWallet
+}
+
+// NewBtcWalletGenSigner creates a new genesis signer backed by the given
+// btcwallet instance.
+func NewBtcWalletGenSigner(wallet *btcwallet.BtcWallet) *BtcWalletGenSigner {
+	return &BtcWalletGenSigner{
+		wallet: wallet,
+	}
+}
+
+// SignVirtualTx produces a witness for a genesis asset issuance proof
+// through the wallet's ComputeInputScript, which signs with the wallet key
+// controlling signDesc.Output's pkScript rather than with signDesc.KeyDesc.
+// prevOut is not used.
+func (b *BtcWalletGenSigner) SignVirtualTx(signDesc *input.SignDescriptor,
+	tx *wire.MsgTx, prevOut *wire.TxOut) (*wire.TxWitness, error) {
+
+	witness, err := b.wallet.ComputeInputScript(tx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &witness.Witness, nil
+}
+
+// A compile-time check to ensure BtcWalletSigner and BtcWalletGenSigner
+// implement their respective interfaces.
+var (
+	_ tapscript.Signer    = (*BtcWalletSigner)(nil)
+	_ tapgarden.GenSigner = (*BtcWalletGenSigner)(nil)
+)
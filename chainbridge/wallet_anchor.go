@@ -0,0 +1,57 @@
+package chainbridge
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/lightninglabs/taro/tapfreighter"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// BtcWalletAnchor is an implementation of the tapfreighter.WalletAnchor
+// interface backed directly by a local btcwallet instance, rather than by an
+// lnd instance.
+type BtcWalletAnchor struct {
+	wallet *btcwallet.BtcWallet
+}
+
+// NewBtcWalletAnchor creates a new wallet anchor backed by the given
+// btcwallet instance.
+func NewBtcWalletAnchor(wallet *btcwallet.BtcWallet) *BtcWalletAnchor {
+	return &BtcWalletAnchor{
+		wallet: wallet,
+	}
+}
+
+// FundPsbt funds the given PSBT packet, adding inputs and a change output as
+// needed to satisfy the fee rate and minimum confirmations requested.
+func (b *BtcWalletAnchor) FundPsbt(_ context.Context, packet *psbt.Packet,
+	minConfs uint32, feeRate chainfee.SatPerKWeight) (int32, error) {
+
+	changeIndex, err := btcwallet.FundPsbt(
+		b.wallet, packet, minConfs, feeRate,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return changeIndex, nil
+}
+
+// SignPsbt signs all inputs in the given PSBT packet that the wallet has the
+// private keys for.
+func (b *BtcWalletAnchor) SignPsbt(_ context.Context,
+	packet *psbt.Packet) (*psbt.Packet, error) {
+
+	_, err := b.wallet.SignPsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	return packet, nil
+}
+
+// A compile-time check to ensure BtcWalletAnchor implements the
+// tapfreighter.WalletAnchor interface.
+var _ tapfreighter.WalletAnchor = (*BtcWalletAnchor)(nil)
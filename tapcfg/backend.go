@@ -0,0 +1,125 @@
+package tapcfg
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taro"
+	"github.com/lightninglabs/taro/tapfreighter"
+	"github.com/lightninglabs/taro/tapgarden"
+	"github.com/lightninglabs/taro/tapscript"
+)
+
+// Backend identifies a chain/wallet backend that can be plugged into a taro
+// daemon.
+type Backend string
+
+const (
+	// BackendLnd sources the chain bridge, wallet, key ring, and signers
+	// from a running lnd node reached over its gRPC interface. This is
+	// the default, and preserves the behavior taro has always had.
+	BackendLnd Backend = "lnd"
+
+	// BackendBitcoind sources chain notifications directly from a
+	// bitcoind full node, and keys/signing from a local btcwallet
+	// instance.
+	BackendBitcoind Backend = "bitcoind"
+
+	// BackendBtcd sources chain notifications directly from a btcd full
+	// node, and keys/signing from a local btcwallet instance.
+	BackendBtcd Backend = "btcd"
+
+	// BackendNeutrino names a neutrino light client backend. No provider
+	// is registered under this name, so selecting it makes
+	// newBackendProvider return an unknown backend error.
+	BackendNeutrino Backend = "neutrino"
+)
+
+// BackendProvider bundles together every backend-specific implementation the
+// taro server needs in order to mint, transfer, and track assets. It plays
+// the same role lnd's ChainControl bundle plays for on-chain wallets: a
+// single seam that lets the rest of the daemon stay agnostic to which node
+// and wallet sit behind it.
+type BackendProvider interface {
+	// ChainBridge returns the bridge used to subscribe to block and
+	// transaction notifications, and to broadcast transactions.
+	ChainBridge() tapgarden.ChainBridge
+
+	// WalletAnchor returns the wallet used to fund and sign the BTC
+	// level anchor transactions that carry Taro commitments.
+	WalletAnchor() tapfreighter.WalletAnchor
+
+	// KeyRing returns the key ring used to derive internal keys for
+	// assets, scripts, and Taro addresses.
+	KeyRing() taro.KeyRing
+
+	// Signer returns the signer used to produce witnesses for virtual
+	// asset-level transactions.
+	Signer() tapscript.Signer
+
+	// GenSigner returns the signer used to sign genesis asset issuance
+	// proofs.
+	GenSigner() tapgarden.GenSigner
+
+	// Start brings the backend online. It must be called before any of
+	// the accessor methods above are relied on.
+	Start() error
+
+	// Stop tears down any connections the backend holds open.
+	Stop() error
+}
+
+// backendProviderFactory builds a BackendProvider from the given tarod
+// config, and, if one has already been dialed by the caller, a connected set
+// of lnd services. lndServices is nil for every backend other than
+// BackendLnd.
+type backendProviderFactory func(cfg *Config, cfgLogger btclog.Logger,
+	lndServices *lndclient.LndServices) (BackendProvider, error)
+
+// backendProviders is the registry of all backend providers tapcfg knows
+// about, keyed by their Backend name. Each provider implementation registers
+// itself from an init() function.
+var backendProviders = make(map[Backend]backendProviderFactory)
+
+// RegisterBackendProvider adds a backend provider to the registry under the
+// given name, so that it can be selected via the Backend config field.
+func RegisterBackendProvider(name Backend, factory backendProviderFactory) {
+	backendProviders[name] = factory
+}
+
+// newBackendProvider looks up and constructs the backend provider selected
+// by cfg.Backend, defaulting to BackendLnd for backward compatibility.
+func newBackendProvider(cfg *Config, cfgLogger btclog.Logger,
+	lndServices *lndclient.LndServices) (BackendProvider, error) {
+
+	backend := cfg.Backend
+	if backend == "" {
+		backend = BackendLnd
+	}
+
+	factory, ok := backendProviders[backend]
+	if !ok {
+		return nil, fmt.Errorf("unknown backend %q, registered "+
+			"backends are: %v", backend, registeredBackendNames())
+	}
+
+	return factory(cfg, cfgLogger, lndServices)
+}
+
+// registeredBackendNames returns the names of every backend provider
+// currently registered, for use in error messages.
+func registeredBackendNames() []Backend {
+	names := make([]Backend, 0, len(backendProviders))
+	for name := range backendProviders {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// backendNeedsLnd returns true if the given backend requires a connection
+// to an lnd node in order to operate.
+func backendNeedsLnd(backend Backend) bool {
+	return backend == "" || backend == BackendLnd
+}
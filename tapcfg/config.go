@@ -0,0 +1,155 @@
+package tapcfg
+
+import (
+	"net"
+	"time"
+
+	"github.com/lightninglabs/taro/tapdb"
+	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chainreg"
+	"github.com/lightningnetwork/lnd/lncfg"
+)
+
+// DatabaseBackend is the string name of a supported database backend.
+type DatabaseBackend string
+
+const (
+	// DatabaseBackendSqlite is the sqlite database backend.
+	DatabaseBackendSqlite DatabaseBackend = "sqlite"
+
+	// DatabaseBackendPostgres is the postgres database backend.
+	DatabaseBackendPostgres DatabaseBackend = "postgres"
+)
+
+// LndConfig houses the fields needed to dial an lnd node over its gRPC
+// interface.
+type LndConfig struct {
+	// Host is the host:port of the lnd node's gRPC listener.
+	Host string
+
+	// MacaroonPath is the path to the admin macaroon used to
+	// authenticate with lnd.
+	MacaroonPath string
+
+	// TLSPath is the path to the TLS certificate presented by lnd.
+	TLSPath string
+}
+
+// HashMailCourierConfig houses the fields needed to reach a hashmail proof
+// courier service.
+type HashMailCourierConfig struct {
+	// Addr is the host:port of the hashmail courier.
+	Addr string
+
+	// TlsCertPath is the path to the courier's TLS certificate, if any.
+	TlsCertPath string
+}
+
+// ChainConfig houses the fields that describe which network taro is
+// operating on.
+type ChainConfig struct {
+	// Network is the string name of the active network (mainnet,
+	// testnet, regtest, signet, simnet).
+	Network string
+}
+
+// RPCConfig houses the fields that configure taro's gRPC and REST listeners.
+type RPCConfig struct {
+	WSPingInterval time.Duration
+	WSPongWait     time.Duration
+
+	RestCORS     []string
+	NoMacaroons  bool
+	MacaroonPath string
+
+	LetsEncryptDir    string
+	LetsEncryptListen string
+	LetsEncryptEmail  string
+	LetsEncryptDomain string
+}
+
+// Config is the main configuration struct for a tarod daemon. It houses
+// everything necessary to configure the backing database, RPC listeners, and
+// the chain/wallet backend the server transacts against.
+type Config struct {
+	// DebugLevel is the log level to use across all subsystems.
+	DebugLevel string
+
+	// ActiveNetParams describes the network tarod is operating on.
+	ActiveNetParams chainreg.BitcoinNetParams
+
+	// DatabaseBackend is the database backend tarod persists state to.
+	DatabaseBackend DatabaseBackend
+
+	// Sqlite holds the sqlite backend's configuration, and is only
+	// required if DatabaseBackend is DatabaseBackendSqlite.
+	Sqlite *tapdb.SqliteConfig
+
+	// Postgres holds the postgres backend's configuration, and is only
+	// required if DatabaseBackend is DatabaseBackendPostgres.
+	Postgres *tapdb.PostgresConfig
+
+	// Backend selects which chain/wallet backend tarod sources its
+	// ChainBridge, WalletAnchor, KeyRing, and signers from. Defaults to
+	// BackendLnd if unset.
+	Backend Backend
+
+	// Lnd holds the connection details for the lnd backend, and is only
+	// required if Backend is BackendLnd (or unset).
+	Lnd *LndConfig
+
+	// Bitcoind holds the connection details for the bitcoind backend,
+	// and is only required if Backend is BackendBitcoind.
+	Bitcoind *lncfg.Bitcoind
+
+	// Btcd holds the connection details for the btcd backend, and is
+	// only required if Backend is BackendBtcd.
+	Btcd *lncfg.Btcd
+
+	// Wallet holds the passphrases used to unlock the local btcwallet
+	// instance backing the direct-RPC backends. It is only required for
+	// those backends, the lnd backend manages its own wallet.
+	Wallet *WalletConfig
+
+	// ChainConf describes which network tarod is operating on.
+	ChainConf ChainConfig
+
+	// HashMailCourier, if set, configures the hashmail proof courier
+	// used to deliver proofs between parties.
+	HashMailCourier *HashMailCourierConfig
+
+	// UniverseSyncInterval is how often the federation envoy syncs with
+	// its peers.
+	UniverseSyncInterval time.Duration
+
+	// BatchMintingInterval is how often the asset minter force-ticks a
+	// pending batch.
+	BatchMintingInterval time.Duration
+
+	// LogWriter is the root logger all tarod subsystems log through.
+	LogWriter *build.RotatingLogWriter
+
+	// RpcConf houses the gRPC/REST listener configuration.
+	RpcConf *RPCConfig
+
+	// networkDir is the tarod data directory for the active network.
+	networkDir string
+
+	// rpcListeners are the listeners tarod's gRPC server accepts
+	// connections on.
+	rpcListeners []net.Addr
+
+	// restListeners are the listeners tarod's REST proxy accepts
+	// connections on.
+	restListeners []net.Addr
+}
+
+// WalletConfig holds the passphrases used to unlock the btcwallet instance
+// that backs a direct-RPC chain/wallet backend.
+type WalletConfig struct {
+	// PrivatePass unlocks the wallet's private keys.
+	PrivatePass string
+
+	// PublicPass unlocks the wallet's public, watch-only data.
+	PublicPass string
+}
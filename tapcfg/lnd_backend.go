@@ -0,0 +1,88 @@
+package tapcfg
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taro"
+	"github.com/lightninglabs/taro/tapfreighter"
+	"github.com/lightninglabs/taro/tapgarden"
+	"github.com/lightninglabs/taro/tapscript"
+)
+
+func init() {
+	RegisterBackendProvider(BackendLnd, newLndBackendProvider)
+}
+
+// lndBackendProvider is the default BackendProvider implementation. It
+// sources the chain bridge, wallet, key ring, and signers from a connected
+// lnd node, and is a drop-in wrapper around the constructors tapcfg has
+// always used.
+type lndBackendProvider struct {
+	lndServices *lndclient.LndServices
+
+	chainBridge  tapgarden.ChainBridge
+	walletAnchor tapfreighter.WalletAnchor
+	keyRing      taro.KeyRing
+	signer       tapscript.Signer
+	genSigner    tapgarden.GenSigner
+}
+
+// newLndBackendProvider builds a BackendProvider backed by an already
+// connected lnd instance. Establishing that connection remains the
+// responsibility of the caller (CreateServerFromConfig, or the sub-server
+// equivalent), since it may be shared with other subsystems outside of
+// tapcfg.
+func newLndBackendProvider(_ *Config, _ btclog.Logger,
+	lndServices *lndclient.LndServices) (BackendProvider, error) {
+
+	if lndServices == nil {
+		return nil, fmt.Errorf("the lnd backend requires a " +
+			"connected lnd instance")
+	}
+
+	return &lndBackendProvider{
+		lndServices:  lndServices,
+		chainBridge:  taro.NewLndRpcChainBridge(lndServices),
+		walletAnchor: taro.NewLndRpcWalletAnchor(lndServices),
+		keyRing:      taro.NewLndRpcKeyRing(lndServices),
+		signer:       taro.NewLndRpcVirtualTxSigner(lndServices),
+		genSigner:    taro.NewLndRpcGenSigner(lndServices),
+	}, nil
+}
+
+// ChainBridge returns the lnd-backed chain bridge.
+func (l *lndBackendProvider) ChainBridge() tapgarden.ChainBridge {
+	return l.chainBridge
+}
+
+// WalletAnchor returns the lnd-backed wallet anchor.
+func (l *lndBackendProvider) WalletAnchor() tapfreighter.WalletAnchor {
+	return l.walletAnchor
+}
+
+// KeyRing returns the lnd-backed key ring.
+func (l *lndBackendProvider) KeyRing() taro.KeyRing {
+	return l.keyRing
+}
+
+// Signer returns the lnd-backed virtual transaction signer.
+func (l *lndBackendProvider) Signer() tapscript.Signer {
+	return l.signer
+}
+
+// GenSigner returns the lnd-backed genesis signer.
+func (l *lndBackendProvider) GenSigner() tapgarden.GenSigner {
+	return l.genSigner
+}
+
+// Start is a no-op, the lnd connection is dialed and owned by the caller.
+func (l *lndBackendProvider) Start() error {
+	return nil
+}
+
+// Stop is a no-op, the lnd connection is closed by the caller.
+func (l *lndBackendProvider) Stop() error {
+	return nil
+}
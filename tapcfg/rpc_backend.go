@@ -0,0 +1,205 @@
+package tapcfg
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btclog"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taro"
+	"github.com/lightninglabs/taro/chainbridge"
+	"github.com/lightninglabs/taro/tapfreighter"
+	"github.com/lightninglabs/taro/tapgarden"
+	"github.com/lightninglabs/taro/tapscript"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+)
+
+func init() {
+	RegisterBackendProvider(BackendBitcoind, newRPCBackendProvider)
+	RegisterBackendProvider(BackendBtcd, newRPCBackendProvider)
+}
+
+// rpcBackendProvider is a BackendProvider that talks directly to a bitcoind
+// or btcd full node for chain notifications, and derives and signs with a
+// local btcwallet instance, so a taro daemon can run without an lnd instance
+// alongside it.
+type rpcBackendProvider struct {
+	cfg *Config
+
+	chainConn *chain.RPCClient
+	wallet    *btcwallet.BtcWallet
+
+	chainBridge  tapgarden.ChainBridge
+	walletAnchor tapfreighter.WalletAnchor
+	keyRing      taro.KeyRing
+	signer       tapscript.Signer
+	genSigner    tapgarden.GenSigner
+}
+
+// newRPCBackendProvider builds a BackendProvider that drives a bitcoind or
+// btcd node directly, keyed off of cfg.Backend. lndServices is always nil
+// here, this provider never dials lnd.
+func newRPCBackendProvider(cfg *Config, _ btclog.Logger,
+	_ *lndclient.LndServices) (BackendProvider, error) {
+
+	return &rpcBackendProvider{cfg: cfg}, nil
+}
+
+// Start dials the configured full node, opens the local btcwallet instance,
+// and wires up the chain bridge, wallet anchor, key ring, and signers that
+// sit on top of them.
+func (r *rpcBackendProvider) Start() error {
+	chainConn, err := dialChainConn(r.cfg)
+	if err != nil {
+		return fmt.Errorf("unable to connect to %v backend: %w",
+			r.cfg.Backend, err)
+	}
+	r.chainConn = chainConn
+
+	if r.cfg.Wallet == nil {
+		return fmt.Errorf("%v backend selected but no wallet "+
+			"passphrases were configured", r.cfg.Backend)
+	}
+
+	wallet, err := btcwallet.New(btcwallet.Config{
+		PrivatePass: []byte(r.cfg.Wallet.PrivatePass),
+		PublicPass:  []byte(r.cfg.Wallet.PublicPass),
+		DataDir:     r.cfg.networkDir,
+		NetParams:   r.cfg.ActiveNetParams.Params,
+		ChainSource: chainConn,
+		CoinType:    r.cfg.ActiveNetParams.CoinType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start btcwallet: %w", err)
+	}
+	if err := wallet.Start(); err != nil {
+		return fmt.Errorf("unable to start btcwallet: %w", err)
+	}
+	r.wallet = wallet
+
+	r.chainBridge = chainbridge.NewRPCChainBridge(
+		chainConn, r.cfg.ActiveNetParams.Params,
+	)
+	r.walletAnchor = chainbridge.NewBtcWalletAnchor(wallet)
+	r.keyRing = chainbridge.NewBtcWalletKeyRing(
+		wallet, r.cfg.ActiveNetParams.CoinType,
+	)
+	r.signer = chainbridge.NewBtcWalletSigner(wallet)
+	r.genSigner = chainbridge.NewBtcWalletGenSigner(wallet)
+
+	return nil
+}
+
+// Stop tears down the btcwallet instance and the connection to the full
+// node.
+func (r *rpcBackendProvider) Stop() error {
+	if r.wallet != nil {
+		if err := r.wallet.Stop(); err != nil {
+			return fmt.Errorf("unable to stop btcwallet: %w", err)
+		}
+	}
+	if r.chainConn != nil {
+		r.chainConn.Stop()
+	}
+
+	return nil
+}
+
+// ChainBridge returns the direct-RPC chain bridge.
+func (r *rpcBackendProvider) ChainBridge() tapgarden.ChainBridge {
+	return r.chainBridge
+}
+
+// WalletAnchor returns the btcwallet-backed wallet anchor.
+func (r *rpcBackendProvider) WalletAnchor() tapfreighter.WalletAnchor {
+	return r.walletAnchor
+}
+
+// KeyRing returns the btcwallet-backed key ring.
+func (r *rpcBackendProvider) KeyRing() taro.KeyRing {
+	return r.keyRing
+}
+
+// Signer returns the btcwallet-backed virtual transaction signer.
+func (r *rpcBackendProvider) Signer() tapscript.Signer {
+	return r.signer
+}
+
+// GenSigner returns the btcwallet-backed genesis signer.
+func (r *rpcBackendProvider) GenSigner() tapgarden.GenSigner {
+	return r.genSigner
+}
+
+// dialChainConn connects to the bitcoind or btcd node described by cfg,
+// returning the btcwallet chain.Interface implementation used both for
+// chain notifications and as the btcwallet backing chain source.
+func dialChainConn(cfg *Config) (*chain.RPCClient, error) {
+	switch cfg.Backend {
+	case BackendBitcoind:
+		if cfg.Bitcoind == nil {
+			return nil, fmt.Errorf("bitcoind backend selected " +
+				"but no bitcoind config was provided")
+		}
+
+		return dialBitcoind(cfg.Bitcoind, cfg.ActiveNetParams.Params)
+
+	case BackendBtcd:
+		if cfg.Btcd == nil {
+			return nil, fmt.Errorf("btcd backend selected but " +
+				"no btcd config was provided")
+		}
+
+		return dialBtcd(cfg.Btcd, cfg.ActiveNetParams.Params)
+
+	default:
+		return nil, fmt.Errorf("unsupported direct-RPC backend: %v",
+			cfg.Backend)
+	}
+}
+
+// dialBitcoind connects to a bitcoind full node's RPC interface, and
+// subscribes to its ZMQ block/tx feeds for notifications, since bitcoind has
+// no native chain-notification RPC of its own.
+func dialBitcoind(bitcoindCfg *lncfg.Bitcoind,
+	netParams *chaincfg.Params) (*chain.RPCClient, error) {
+
+	bitcoindConn, err := chain.NewBitcoindConn(&chain.BitcoindConfig{
+		ChainParams: netParams,
+		Host:        bitcoindCfg.RPCHost,
+		User:        bitcoindCfg.RPCUser,
+		Pass:        bitcoindCfg.RPCPass,
+		ZMQConfig: &chain.ZMQConfig{
+			ZMQBlockHost: bitcoindCfg.ZMQPubRawBlock,
+			ZMQTxHost:    bitcoindCfg.ZMQPubRawTx,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := bitcoindConn.Start(); err != nil {
+		return nil, fmt.Errorf("unable to connect to bitcoind: %w",
+			err)
+	}
+
+	return bitcoindConn.NewBitcoindClient(), nil
+}
+
+// dialBtcd connects to a btcd full node's websocket RPC interface, which
+// natively supports the block/tx notifications taro needs.
+func dialBtcd(btcdCfg *lncfg.Btcd,
+	netParams *chaincfg.Params) (*chain.RPCClient, error) {
+
+	rpcCert, err := os.ReadFile(btcdCfg.RPCCert)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read btcd RPC cert: %w",
+			err)
+	}
+
+	return chain.NewRPCClient(
+		netParams, btcdCfg.RPCHost, btcdCfg.RPCUser, btcdCfg.RPCPass,
+		rpcCert, false, 20,
+	)
+}
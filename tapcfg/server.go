@@ -27,16 +27,45 @@ type databaseBackend interface {
 	WithTx(tx *sql.Tx) *sqlc.Queries
 }
 
-// genServerConfig generates a server config from the given tarod config.
+// genServerConfig generates a server config from the given tarod config. It
+// also returns the BackendProvider it created, so the caller can stop it as
+// part of the daemon's shutdown sequence.
 //
 // NOTE: The RPCConfig and SignalInterceptor fields must be set by the caller
 // after genereting the server config.
 func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	lndServices *lndclient.LndServices,
-	mainErrChan chan<- error) (*taro.Config, error) {
+	mainErrChan chan<- error) (*taro.Config, BackendProvider, error) {
 
 	var err error
 
+	backend, err := newBackendProvider(cfg, cfgLogger, lndServices)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create %v backend: %w",
+			cfg.Backend, err)
+	}
+	if err := backend.Start(); err != nil {
+		return nil, nil, fmt.Errorf("unable to start %v backend: %w",
+			cfg.Backend, err)
+	}
+
+	// From here on, backend is fully started. If anything else in this
+	// function fails, stop it before returning rather than leaking its
+	// wallet and full-node connections; on success, ownership passes to
+	// the caller, who stops it as part of the daemon's own shutdown.
+	configSucceeded := false
+	defer func() {
+		if configSucceeded {
+			return
+		}
+
+		if stopErr := backend.Stop(); stopErr != nil {
+			cfgLogger.Errorf("Unable to cleanly stop %v backend "+
+				"after failed startup: %v", cfg.Backend,
+				stopErr)
+		}
+	}()
+
 	// Now that we know where the database will live, we'll go ahead and
 	// open up the default implementation of it.
 	var db databaseBackend
@@ -52,11 +81,11 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		db, err = tapdb.NewPostgresStore(cfg.Postgres)
 
 	default:
-		return nil, fmt.Errorf("unknown database backend: %s",
+		return nil, nil, fmt.Errorf("unknown database backend: %s",
 			cfg.DatabaseBackend)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("unable to open database: %v", err)
+		return nil, nil, fmt.Errorf("unable to open database: %v", err)
 	}
 
 	rksDB := tapdb.NewTransactionExecutor(
@@ -87,9 +116,9 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		addrBookDB, &taroChainParams,
 	)
 
-	keyRing := taro.NewLndRpcKeyRing(lndServices)
-	walletAnchor := taro.NewLndRpcWalletAnchor(lndServices)
-	chainBridge := taro.NewLndRpcChainBridge(lndServices)
+	keyRing := backend.KeyRing()
+	walletAnchor := backend.WalletAnchor()
+	chainBridge := backend.ChainBridge()
 
 	addrBook := address.NewBook(address.BookConfig{
 		Store:        tapdbAddrBook,
@@ -142,7 +171,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	proofFileStore, err := proof.NewFileArchiver(cfg.networkDir)
 	if err != nil {
-		return nil, fmt.Errorf("unable to open disk archive: %v", err)
+		return nil, nil, fmt.Errorf("unable to open disk archive: %v", err)
 	}
 	proofArchive := proof.NewMultiArchiver(
 		&proof.BaseVerifier{}, tapdb.DefaultStoreTimeout,
@@ -156,7 +185,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			cfg.HashMailCourier.TlsCertPath,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to make mailbox: %v",
+			return nil, nil, fmt.Errorf("unable to make mailbox: %v",
 				err)
 		}
 
@@ -164,7 +193,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			cfg.HashMailCourier, hashMailBox, assetStore,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to make hashmail "+
+			return nil, nil, fmt.Errorf("unable to make hashmail "+
 				"courier: %v", err)
 		}
 	}
@@ -188,7 +217,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		},
 	)
 
-	virtualTxSigner := taro.NewLndRpcVirtualTxSigner(lndServices)
+	virtualTxSigner := backend.Signer()
 	coinSelect := tapfreighter.NewCoinSelect(assetStore)
 	assetWallet := tapfreighter.NewAssetWallet(&tapfreighter.WalletConfig{
 		CoinSelector: coinSelect,
@@ -201,6 +230,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		ChainParams:  &taroChainParams,
 	})
 
+	configSucceeded = true
+
 	return &taro.Config{
 		DebugLevel:  cfg.DebugLevel,
 		ChainParams: cfg.ActiveNetParams,
@@ -210,11 +241,9 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				ChainBridge: chainBridge,
 				Log:         assetMintingStore,
 				KeyRing:     keyRing,
-				GenSigner: taro.NewLndRpcGenSigner(
-					lndServices,
-				),
-				ProofFiles: proofFileStore,
-				Universe:   universeFederation,
+				GenSigner:   backend.GenSigner(),
+				ProofFiles:  proofFileStore,
+				Universe:    universeFederation,
 			},
 			BatchTicker: ticker.NewForce(cfg.BatchMintingInterval),
 			ErrChan:     mainErrChan,
@@ -263,7 +292,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			UniverseForest: uniForest,
 			FederationDB:   federationDB,
 		},
-	}, nil
+	}, backend, nil
 }
 
 // CreateServerFromConfig creates a new Taro server from the given CLI config.
@@ -282,25 +311,48 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 			err)
 	}
 
-	cfgLogger.Infof("Attempting to establish connection to lnd...")
+	// Only the lnd backend needs a connection dialed up front, the
+	// direct-RPC backends dial their own full node and wallet as part of
+	// the provider's Start method.
+	var lndServices *lndclient.LndServices
+	if backendNeedsLnd(cfg.Backend) {
+		cfgLogger.Infof("Attempting to establish connection to " +
+			"lnd...")
 
-	lndConn, err := getLnd(
-		cfg.ChainConf.Network, cfg.Lnd, shutdownInterceptor,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("unable to connect to lnd node: %v", err)
-	}
+		lndConn, err := getLnd(
+			cfg.ChainConf.Network, cfg.Lnd, shutdownInterceptor,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to connect to lnd "+
+				"node: %v", err)
+		}
+
+		cfgLogger.Infof("lnd connection initialized")
 
-	cfgLogger.Infof("lnd connection initialized")
+		lndServices = &lndConn.LndServices
+	}
 
-	serverCfg, err := genServerConfig(
-		cfg, cfgLogger, &lndConn.LndServices, mainErrChan,
+	serverCfg, backend, err := genServerConfig(
+		cfg, cfgLogger, lndServices, mainErrChan,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate server config: %v",
 			err)
 	}
 
+	// Stop the backend (closing its wallet and full-node connections, if
+	// it has any of its own) once the daemon starts shutting down. The
+	// lnd backend's Stop is a no-op, its connection is owned by getLnd
+	// above.
+	go func() {
+		<-shutdownInterceptor.ShutdownChannel()
+
+		if err := backend.Stop(); err != nil {
+			cfgLogger.Errorf("Unable to cleanly stop %v "+
+				"backend: %v", cfg.Backend, err)
+		}
+	}()
+
 	serverCfg.SignalInterceptor = shutdownInterceptor
 
 	serverCfg.RPCConfig = &taro.RPCConfig{
@@ -329,7 +381,14 @@ func CreateSubServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 	lndServices *lndclient.LndServices,
 	mainErrChan chan<- error) (*taro.Server, error) {
 
-	serverCfg, err := genServerConfig(
+	// A sub-server is always handed an already-connected lnd instance, so
+	// the backend it gets here is always the lnd one, whose Stop is a
+	// no-op; the parent process owns the lnd connection's lifecycle.
+	if !backendNeedsLnd(cfg.Backend) {
+		return nil, fmt.Errorf("sub-server mode only supports the %v "+
+			"backend, got %v", BackendLnd, cfg.Backend)
+	}
+	serverCfg, _, err := genServerConfig(
 		cfg, cfgLogger, lndServices, mainErrChan,
 	)
 	if err != nil {
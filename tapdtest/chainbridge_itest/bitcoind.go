@@ -0,0 +1,64 @@
+//go:build itest
+
+package chainbridge_itest
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/integration/rpctest"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/lightninglabs/taro/chainbridge"
+)
+
+// newBitcoindChainBridgeHarness connects a ChainBridge to a bitcoind node
+// started alongside the given btcd miner, driving notifications over ZMQ
+// rather than the miner's native RPC, the same way the direct-RPC
+// BackendProvider does in production.
+//
+// The bitcoind node mines nothing itself, every block it sees originates
+// from the btcd miner, so the two stay in sync without a second source of
+// truth for chain tip.
+func newBitcoindChainBridgeHarness(kind backendKind,
+	miner *rpctest.Harness) (*chainBridgeHarness, error) {
+
+	bitcoindNode, err := newBitcoindNode(miner)
+	if err != nil {
+		return nil, fmt.Errorf("unable to start bitcoind: %w", err)
+	}
+
+	bitcoindConn, err := chain.NewBitcoindConn(&chain.BitcoindConfig{
+		ChainParams: activeNetParams,
+		Host:        bitcoindNode.rpcHost,
+		User:        bitcoindNode.rpcUser,
+		Pass:        bitcoindNode.rpcPass,
+		ZMQConfig: &chain.ZMQConfig{
+			ZMQBlockHost: bitcoindNode.zmqBlockHost,
+			ZMQTxHost:    bitcoindNode.zmqTxHost,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to bitcoind: %w",
+			err)
+	}
+	if err := bitcoindConn.Start(); err != nil {
+		return nil, fmt.Errorf("unable to start bitcoind conn: %w",
+			err)
+	}
+
+	chainConn := bitcoindConn.NewBitcoindClient()
+
+	wallet, err := newHarnessWallet(kind, chainConn)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create wallet: %w", err)
+	}
+
+	return &chainBridgeHarness{
+		kind:      kind,
+		miner:     miner,
+		chainConn: chainConn,
+		wallet:    wallet,
+		node:      bitcoindNode,
+		bridge:    chainbridge.NewRPCChainBridge(chainConn, activeNetParams),
+		anchor:    chainbridge.NewBtcWalletAnchor(wallet),
+	}, nil
+}
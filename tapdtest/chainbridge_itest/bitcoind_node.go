@@ -0,0 +1,152 @@
+//go:build itest
+
+package chainbridge_itest
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/btcsuite/btcd/integration/rpctest"
+	"github.com/btcsuite/btcd/rpcclient"
+)
+
+// bitcoindNode is a minimal handle on a bitcoind regtest process dedicated
+// to a single test, with its own data directory and RPC/ZMQ endpoints.
+type bitcoindNode struct {
+	cmd *exec.Cmd
+	dir string
+
+	rpcHost string
+	rpcUser string
+	rpcPass string
+
+	zmqBlockHost string
+	zmqTxHost    string
+}
+
+// newBitcoindNode launches a bitcoind instance in regtest mode, configured
+// to accept blocks mined by the given btcd harness over p2p, and to publish
+// block/tx notifications over ZMQ.
+func newBitcoindNode(miner *rpctest.Harness) (*bitcoindNode, error) {
+	dir, err := os.MkdirTemp("", "chainbridge-itest-bitcoind")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temp dir: %w", err)
+	}
+
+	const (
+		rpcUser = "chainbridge"
+		rpcPass = "chainbridge"
+	)
+
+	rpcPort, err := freePort()
+	if err != nil {
+		return nil, fmt.Errorf("unable to allocate rpc port: %w", err)
+	}
+	zmqBlockPort, err := freePort()
+	if err != nil {
+		return nil, fmt.Errorf("unable to allocate zmq block port: %w",
+			err)
+	}
+	zmqTxPort, err := freePort()
+	if err != nil {
+		return nil, fmt.Errorf("unable to allocate zmq tx port: %w",
+			err)
+	}
+
+	node := &bitcoindNode{
+		dir:     dir,
+		rpcHost: fmt.Sprintf("127.0.0.1:%d", rpcPort),
+		rpcUser: rpcUser,
+		rpcPass: rpcPass,
+		zmqBlockHost: fmt.Sprintf(
+			"tcp://127.0.0.1:%d", zmqBlockPort,
+		),
+		zmqTxHost: fmt.Sprintf("tcp://127.0.0.1:%d", zmqTxPort),
+	}
+
+	args := []string{
+		"-regtest",
+		"-datadir=" + dir,
+		"-rpcuser=" + rpcUser,
+		"-rpcpassword=" + rpcPass,
+		fmt.Sprintf("-rpcport=%d", rpcPort),
+		"-zmqpubrawblock=" + node.zmqBlockHost,
+		"-zmqpubrawtx=" + node.zmqTxHost,
+		"-txindex",
+		"-connect=" + miner.P2PAddr(),
+	}
+
+	node.cmd = exec.Command(filepath.Join("bitcoind"), args...)
+	if err := node.cmd.Start(); err != nil {
+		return nil, fmt.Errorf("unable to start bitcoind: %w", err)
+	}
+
+	if err := waitForBitcoindReady(node); err != nil {
+		_ = node.tearDown()
+		return nil, fmt.Errorf("bitcoind never became ready: %w", err)
+	}
+
+	return node, nil
+}
+
+// nodeReadyTimeout bounds how long waitForBitcoindReady polls before giving
+// up on a bitcoind process that never opens its RPC socket.
+const nodeReadyTimeout = 10 * time.Second
+
+// waitForBitcoindReady polls node's RPC endpoint with a cheap, side-effect
+// free call until it answers or nodeReadyTimeout elapses, rather than
+// sleeping a fixed duration and hoping the node is up by then.
+func waitForBitcoindReady(node *bitcoindNode) error {
+	connCfg := &rpcclient.ConnConfig{
+		Host:         node.rpcHost,
+		User:         node.rpcUser,
+		Pass:         node.rpcPass,
+		HTTPPostMode: true,
+		DisableTLS:   true,
+	}
+
+	deadline := time.Now().Add(nodeReadyTimeout)
+	var lastErr error
+	for time.Now().Before(deadline) {
+		client, err := rpcclient.New(connCfg, nil)
+		if err == nil {
+			_, err = client.GetBlockCount()
+			client.Shutdown()
+		}
+		if err == nil {
+			return nil
+		}
+
+		lastErr = err
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return lastErr
+}
+
+// freePort asks the OS for an unused TCP port by briefly binding to port 0,
+// so concurrent or repeated test runs never collide on a fixed port number.
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+// tearDown stops the bitcoind process and removes its data directory.
+func (b *bitcoindNode) tearDown() error {
+	if b.cmd != nil && b.cmd.Process != nil {
+		if err := b.cmd.Process.Kill(); err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(b.dir)
+}
@@ -0,0 +1,49 @@
+//go:build itest
+
+package chainbridge_itest
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/integration/rpctest"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/lightninglabs/taro/chainbridge"
+)
+
+// activeNetParams is the chain the harness mines on. Regtest keeps block
+// generation and the reorg scenario below fast and deterministic.
+var activeNetParams = &chaincfg.RegressionNetParams
+
+// newBtcdChainBridgeHarness connects a ChainBridge directly to the given
+// miner's own btcd RPC interface.
+func newBtcdChainBridgeHarness(kind backendKind,
+	miner *rpctest.Harness) (*chainBridgeHarness, error) {
+
+	rpcCfg := miner.RPCConfig()
+
+	chainConn, err := chain.NewRPCClient(
+		activeNetParams, rpcCfg.Host, rpcCfg.User, rpcCfg.Pass,
+		rpcCfg.Certificates, false, 20,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to btcd: %w", err)
+	}
+	if err := chainConn.Start(); err != nil {
+		return nil, fmt.Errorf("unable to start btcd conn: %w", err)
+	}
+
+	wallet, err := newHarnessWallet(kind, chainConn)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create wallet: %w", err)
+	}
+
+	return &chainBridgeHarness{
+		kind:      kind,
+		miner:     miner,
+		chainConn: chainConn,
+		wallet:    wallet,
+		bridge:    chainbridge.NewRPCChainBridge(chainConn, activeNetParams),
+		anchor:    chainbridge.NewBtcWalletAnchor(wallet),
+	}, nil
+}
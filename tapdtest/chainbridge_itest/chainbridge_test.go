@@ -0,0 +1,68 @@
+//go:build itest
+
+package chainbridge_itest
+
+import (
+	"testing"
+)
+
+// chainBridgeTestCase is a single scenario run against every backend in
+// allBackends, modeled on the table lnwallet's test_interface.go drives
+// across btcd, bitcoind, and neutrino.
+type chainBridgeTestCase struct {
+	name string
+	test func(t *testing.T, h *chainBridgeHarness)
+}
+
+// chainBridgeTestCases is the shared scenario table. Every scenario here
+// runs once per backend in allBackends, so a backend-specific regression in
+// notification delivery, reorg handling, or fee bumping shows up as a single
+// backend failing rather than being invisible, as it is today when
+// everything is mocked around a single lnd instance.
+var chainBridgeTestCases = []chainBridgeTestCase{
+	{
+		name: "block and tx notifications",
+		test: testBlockAndTxNotifications,
+	},
+	{
+		name: "reorg around asset genesis point",
+		test: testReorgAroundGenesisPoint,
+	},
+	{
+		name: "mempool acceptance of anchor transaction",
+		test: testMempoolAcceptAnchorTx,
+	},
+	{
+		name: "fee bump anchor transaction via CPFP",
+		test: testCPFPFeeBump,
+	},
+	{
+		name: "confirmation wait with variable numConfs",
+		test: testConfirmationWaitVariableNumConfs,
+	},
+}
+
+// TestChainBridgeInterfaces runs the full chainBridgeTestCases table against
+// every backend in allBackends, each with its own miner and wallet seed.
+func TestChainBridgeInterfaces(t *testing.T) {
+	for _, backend := range allBackends {
+		backend := backend
+
+		t.Run(string(backend), func(t *testing.T) {
+			h, err := newChainBridgeHarness(backend)
+			if err != nil {
+				t.Fatalf("unable to create %v harness: %v",
+					backend, err)
+			}
+			defer h.tearDown()
+
+			for _, tc := range chainBridgeTestCases {
+				tc := tc
+
+				t.Run(tc.name, func(t *testing.T) {
+					tc.test(t, h)
+				})
+			}
+		})
+	}
+}
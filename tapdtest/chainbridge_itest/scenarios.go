@@ -0,0 +1,304 @@
+//go:build itest
+
+package chainbridge_itest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// defaultTestTimeout bounds how long any single scenario waits for a
+// notification before failing, so a stuck backend fails the test instead of
+// hanging the suite.
+const defaultTestTimeout = 30 * time.Second
+
+// testBlockAndTxNotifications mines a block and confirms the ChainBridge
+// delivers both a block-epoch notification and a confirmation notification
+// for a transaction included in it.
+func testBlockAndTxNotifications(t *testing.T, h *chainBridgeHarness) {
+	ctx := context.Background()
+
+	epochChan, epochErrChan, err := h.bridge.RegisterBlockEpochNtfn(ctx)
+	require.NoError(t, err)
+
+	addr, err := h.miner.NewAddress()
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	txid, err := h.miner.Client.SendToAddress(
+		addr, btcutil.Amount(100_000),
+	)
+	require.NoError(t, err)
+
+	confChan, confErrChan, err := h.bridge.RegisterConfirmationsNtfn(
+		ctx, txid, pkScript, 1, 0,
+	)
+	require.NoError(t, err)
+
+	_, err = h.miner.Client.Generate(1)
+	require.NoError(t, err)
+
+	select {
+	case <-epochChan:
+	case err := <-epochErrChan:
+		t.Fatalf("block epoch notification failed: %v", err)
+	case <-time.After(defaultTestTimeout):
+		t.Fatal("timed out waiting for block epoch notification")
+	}
+
+	select {
+	case conf := <-confChan.Confirmed:
+		require.Equal(t, *txid, conf.Tx.TxHash())
+	case err := <-confErrChan:
+		t.Fatalf("confirmation notification failed: %v", err)
+	case <-time.After(defaultTestTimeout):
+		t.Fatal("timed out waiting for confirmation notification")
+	}
+}
+
+// testReorgAroundGenesisPoint mines a simulated asset genesis transaction,
+// then forces a reorg that invalidates the block it confirmed in, verifying
+// that a confirmation registered before the reorg does not fire on the
+// now-orphaned block.
+func testReorgAroundGenesisPoint(t *testing.T, h *chainBridgeHarness) {
+	ctx := context.Background()
+
+	addr, err := h.miner.NewAddress()
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	genesisTxid, err := h.miner.Client.SendToAddress(
+		addr, btcutil.Amount(50_000),
+	)
+	require.NoError(t, err)
+
+	confChan, confErrChan, err := h.bridge.RegisterConfirmationsNtfn(
+		ctx, genesisTxid, pkScript, 3, 0,
+	)
+	require.NoError(t, err)
+
+	orphanHashes, err := h.miner.Client.Generate(1)
+	require.NoError(t, err)
+	require.Len(t, orphanHashes, 1)
+
+	// Invalidate the block the genesis transaction confirmed in, and
+	// mine a longer, competing chain over it. The confirmation above
+	// requires 3 confs, so it must not fire until the transaction has
+	// reconfirmed on the new best chain.
+	require.NoError(t, h.miner.Client.InvalidateBlock(orphanHashes[0]))
+	_, err = h.miner.Client.Generate(4)
+	require.NoError(t, err)
+
+	select {
+	case conf := <-confChan.Confirmed:
+		require.Equal(t, *genesisTxid, conf.Tx.TxHash())
+		require.NotEqual(t, *orphanHashes[0], *conf.BlockHash)
+	case err := <-confErrChan:
+		t.Fatalf("confirmation notification failed: %v", err)
+	case <-time.After(defaultTestTimeout):
+		t.Fatal("timed out waiting for post-reorg confirmation")
+	}
+}
+
+// testMempoolAcceptAnchorTx verifies that an anchor transaction funded and
+// signed through the WalletAnchor under test is accepted into the backend's
+// mempool once broadcast through the ChainBridge.
+func testMempoolAcceptAnchorTx(t *testing.T, h *chainBridgeHarness) {
+	anchorTx, _ := fundAnchorTx(t, h, 10*chainfee.FeePerKwFloor)
+
+	require.NoError(t, h.bridge.PublishTransaction(anchorTx))
+
+	txid := anchorTx.TxHash()
+	require.Eventually(t, func() bool {
+		_, err := h.miner.Client.GetMempoolEntry(txid.String())
+		return err == nil
+	}, defaultTestTimeout, 200*time.Millisecond)
+}
+
+// testCPFPFeeBump broadcasts an anchor transaction funded at the bare relay
+// fee floor, then broadcasts a child transaction spending its payment
+// output, and verifies that the mempool holds the parent with the child
+// counted among its descendants, the package CPFP relies on to pull the
+// parent's effective fee rate up.
+func testCPFPFeeBump(t *testing.T, h *chainBridgeHarness) {
+	parentTx, changeIndex := fundAnchorTx(t, h, chainfee.FeePerKwFloor)
+	require.NoError(t, h.bridge.PublishTransaction(parentTx))
+
+	childTx := spendPaymentOutput(t, h, parentTx, changeIndex)
+	require.NoError(t, h.bridge.PublishTransaction(childTx))
+
+	parentTxid := parentTx.TxHash()
+	require.Eventually(t, func() bool {
+		entry, err := h.miner.Client.GetMempoolEntry(
+			parentTxid.String(),
+		)
+		if err != nil {
+			return false
+		}
+
+		return len(entry.Depends) == 0 && entry.DescendantCount > 1
+	}, defaultTestTimeout, 200*time.Millisecond)
+}
+
+// testConfirmationWaitVariableNumConfs registers confirmation notifications
+// for the same transaction at two different confirmation depths, and checks
+// that each fires only once its own threshold is met.
+func testConfirmationWaitVariableNumConfs(t *testing.T, h *chainBridgeHarness) {
+	ctx := context.Background()
+
+	addr, err := h.miner.NewAddress()
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	txid, err := h.miner.Client.SendToAddress(
+		addr, btcutil.Amount(25_000),
+	)
+	require.NoError(t, err)
+
+	shallowConf, shallowErr, err := h.bridge.RegisterConfirmationsNtfn(
+		ctx, txid, pkScript, 1, 0,
+	)
+	require.NoError(t, err)
+
+	deepConf, deepErr, err := h.bridge.RegisterConfirmationsNtfn(
+		ctx, txid, pkScript, 6, 0,
+	)
+	require.NoError(t, err)
+
+	_, err = h.miner.Client.Generate(1)
+	require.NoError(t, err)
+
+	select {
+	case <-shallowConf.Confirmed:
+	case err := <-shallowErr:
+		t.Fatalf("shallow confirmation failed: %v", err)
+	case <-time.After(defaultTestTimeout):
+		t.Fatal("timed out waiting for shallow confirmation")
+	}
+
+	select {
+	case <-deepConf.Confirmed:
+		t.Fatal("deep confirmation fired before its threshold was met")
+	case <-time.After(2 * time.Second):
+	}
+
+	_, err = h.miner.Client.Generate(5)
+	require.NoError(t, err)
+
+	select {
+	case <-deepConf.Confirmed:
+	case err := <-deepErr:
+		t.Fatalf("deep confirmation failed: %v", err)
+	case <-time.After(defaultTestTimeout):
+		t.Fatal("timed out waiting for deep confirmation")
+	}
+}
+
+// fundAnchorTx mines a coin to a fresh address owned by the wallet under
+// test, then funds and signs a transaction spending it at the given fee rate
+// through the WalletAnchor's FundPsbt/SignPsbt pair, the same path the taro
+// wallet uses to produce a real anchor transaction in production. This is
+// deliberately not a node-originated send: the node never sees or signs
+// this transaction until it is broadcast through the ChainBridge below.
+//
+// It also returns the change output index FundPsbt reports, which is -1 if
+// funding needed no change output at all.
+func fundAnchorTx(t *testing.T, h *chainBridgeHarness,
+	feeRate chainfee.SatPerKWeight) (*wire.MsgTx, int32) {
+
+	ctx := context.Background()
+
+	fundingAddr, err := h.wallet.NewAddress(
+		lnwallet.WitnessPubKey, false, lnwallet.DefaultAccountName,
+	)
+	require.NoError(t, err)
+
+	_, err = h.miner.Client.SendToAddress(
+		fundingAddr, btcutil.Amount(200_000),
+	)
+	require.NoError(t, err)
+	_, err = h.miner.Client.Generate(1)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		balance, err := h.wallet.ConfirmedBalance(
+			1, lnwallet.DefaultAccountName,
+		)
+		return err == nil && balance > 0
+	}, defaultTestTimeout, 200*time.Millisecond)
+
+	destAddr, err := h.miner.NewAddress()
+	require.NoError(t, err)
+	destScript, err := txscript.PayToAddrScript(destAddr)
+	require.NoError(t, err)
+
+	packet, err := psbt.New(
+		nil, []*wire.TxOut{{Value: 50_000, PkScript: destScript}},
+		2, 0, nil,
+	)
+	require.NoError(t, err)
+
+	changeIndex, err := h.anchor.FundPsbt(ctx, packet, 1, feeRate)
+	require.NoError(t, err)
+
+	signedPacket, err := h.anchor.SignPsbt(ctx, packet)
+	require.NoError(t, err)
+
+	finalTx, err := psbt.Extract(signedPacket)
+	require.NoError(t, err)
+
+	return finalTx, changeIndex
+}
+
+// spendPaymentOutput builds a child transaction spending parentTx's payment
+// output, i.e. whichever output isn't the wallet-under-test's own change
+// output at changeIndex (changeIndex is -1 when funding needed no change, in
+// which case the lone output is the payment). That payment output pays an
+// address fundAnchorTx obtained from the miner, so the miner's own RPC
+// wallet holds its private key and can sign a transaction spending it below
+// - the wallet under test's change output could not be signed this way.
+func spendPaymentOutput(t *testing.T, h *chainBridgeHarness,
+	parentTx *wire.MsgTx, changeIndex int32) *wire.MsgTx {
+
+	paymentIndex := uint32(0)
+	if changeIndex == 0 {
+		paymentIndex = 1
+	}
+
+	addr, err := h.miner.NewAddress()
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	parentTxid := parentTx.TxHash()
+
+	child := wire.NewMsgTx(wire.TxVersion)
+	child.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  parentTxid,
+			Index: paymentIndex,
+		},
+	})
+	child.AddTxOut(&wire.TxOut{
+		Value:    parentTx.TxOut[paymentIndex].Value - 10_000,
+		PkScript: pkScript,
+	})
+
+	signedChild, isSigned, err := h.miner.Client.SignRawTransaction(child)
+	require.NoError(t, err)
+	require.True(t, isSigned)
+
+	return signedChild
+}
@@ -0,0 +1,45 @@
+//go:build itest
+
+package chainbridge_itest
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+)
+
+// newHarnessWallet creates a fresh btcwallet instance for the given backend,
+// each with its own temp data directory and randomly generated seed. Keeping
+// every backend's wallet state isolated, rather than sharing one wallet
+// across the matrix, is what avoids the cross-backend rescan flakes the
+// lnwallet interface tests are also careful to avoid.
+func newHarnessWallet(kind backendKind,
+	chainConn *chain.RPCClient) (*btcwallet.BtcWallet, error) {
+
+	walletDir, err := os.MkdirTemp(
+		"", fmt.Sprintf("chainbridge-itest-wallet-%s", kind),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create wallet dir: %w", err)
+	}
+
+	wallet, err := btcwallet.New(btcwallet.Config{
+		PrivatePass: []byte("chainbridge-itest"),
+		PublicPass:  []byte("public"),
+		DataDir:     walletDir,
+		NetParams:   activeNetParams,
+		ChainSource: chainConn,
+		CoinType:    keychain.CoinTypeTestnet,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create btcwallet: %w", err)
+	}
+	if err := wallet.Start(); err != nil {
+		return nil, fmt.Errorf("unable to start btcwallet: %w", err)
+	}
+
+	return wallet, nil
+}